@@ -0,0 +1,58 @@
+package ssmconfig
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrInvalidSpec is returned when the spec passed to Process isn't a non-nil pointer to
+// a struct.
+type ErrInvalidSpec struct {
+	Reason string
+}
+
+func (e *ErrInvalidSpec) Error() string {
+	return "ssmconfig: invalid spec: " + e.Reason
+}
+
+// ErrMissingParameters is returned when one or more required parameters couldn't be
+// resolved by their provider.
+type ErrMissingParameters struct {
+	Names []string
+}
+
+func (e *ErrMissingParameters) Error() string {
+	return fmt.Sprintf("ssmconfig: missing required parameters: %s", strings.Join(e.Names, ", "))
+}
+
+// ErrUnresolvedParameters is returned by a Provider that can explicitly enumerate which
+// of the names it was asked for had no value (e.g. AWS SSM's InvalidParameters), as
+// opposed to the call itself failing. Process already accounts for every name in here
+// via default/required handling -- a default fills it in, a required field ends up in
+// ErrMissingParameters, an optional field is left at its zero value -- so it never
+// surfaces this error directly; it's exported so other Providers can return it too.
+type ErrUnresolvedParameters struct {
+	Names []string
+}
+
+func (e *ErrUnresolvedParameters) Error() string {
+	return fmt.Sprintf("ssmconfig: could not resolve parameters: %s", strings.Join(e.Names, ", "))
+}
+
+// ErrParseField is returned when a resolved value can't be parsed into its destination
+// field, wrapping the underlying conversion error along with the parameter name and
+// field kind so callers can tell which field to look at.
+type ErrParseField struct {
+	Name  string
+	Kind  reflect.Kind
+	Cause error
+}
+
+func (e *ErrParseField) Error() string {
+	return fmt.Sprintf("ssmconfig: parse %q into %s field: %v", e.Name, e.Kind, e.Cause)
+}
+
+func (e *ErrParseField) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,111 @@
+package ssmconfig
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessAppliesDefaultWhenMissing(t *testing.T) {
+	type Config struct {
+		Key string `ssmparam:"/test/key,default=fallback"`
+	}
+
+	cfg := &Config{}
+	err := Process(&mockSSM{paramsOutput: &ssm.GetParametersOutput{}}, "", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "fallback", cfg.Key)
+}
+
+func TestProcessAppliesDefaultWhenSSMReportsInvalidParameter(t *testing.T) {
+	type Config struct {
+		Key string `ssmparam:"/test/key,default=fallback"`
+	}
+
+	cfg := &Config{}
+	err := Process(&mockSSM{paramsOutput: &ssm.GetParametersOutput{
+		InvalidParameters: []*string{aws.String("/test/key")},
+	}}, "", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "fallback", cfg.Key)
+}
+
+func TestProcessErrsOnMissingRequired(t *testing.T) {
+	type Config struct {
+		Key    string `ssmparam:"/test/key,required"`
+		Other  string `ssmparam:"/test/other,required"`
+		Normal string `ssmparam:"/test/normal"`
+	}
+
+	cfg := &Config{}
+	err := Process(&mockSSM{paramsOutput: &ssm.GetParametersOutput{}}, "", cfg)
+	var missingErr *ErrMissingParameters
+	assert.ErrorAs(t, err, &missingErr)
+	assert.ElementsMatch(t, []string{"/test/key", "/test/other"}, missingErr.Names)
+}
+
+func TestProcessErrsWithMissingParametersNotRawInvalidParamsErrorWhenSSMReportsThem(t *testing.T) {
+	type Config struct {
+		Key    string `ssmparam:"/test/key,required"`
+		Other  string `ssmparam:"/test/other,required"`
+		Normal string `ssmparam:"/test/normal"`
+	}
+
+	cfg := &Config{}
+	err := Process(&mockSSM{paramsOutput: &ssm.GetParametersOutput{
+		InvalidParameters: []*string{aws.String("/test/key"), aws.String("/test/other"), aws.String("/test/normal")},
+	}}, "", cfg)
+	var missingErr *ErrMissingParameters
+	assert.ErrorAs(t, err, &missingErr)
+	assert.ElementsMatch(t, []string{"/test/key", "/test/other"}, missingErr.Names)
+
+	var unresolvedErr *ErrUnresolvedParameters
+	assert.NotErrorAs(t, err, &unresolvedErr)
+}
+
+type upperCaseValue struct {
+	value string
+}
+
+func (u *upperCaseValue) UnmarshalSSM(value string) error {
+	u.value = strings.ToUpper(value)
+	return nil
+}
+
+func TestProcessUsesCustomUnmarshaler(t *testing.T) {
+	type Config struct {
+		Key upperCaseValue `ssmparam:"/test/key"`
+	}
+
+	cfg := &Config{}
+	err := Process(&mockSSM{
+		paramsOutput: &ssm.GetParametersOutput{
+			Parameters: []*ssm.Parameter{
+				{Name: aws.String("/test/key"), Value: aws.String("hello")},
+			},
+		},
+	}, "", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "HELLO", cfg.Key.value)
+}
+
+func TestProcessUsesTextUnmarshaler(t *testing.T) {
+	type Config struct {
+		IP net.IP `ssmparam:"/test/ip"`
+	}
+
+	cfg := &Config{}
+	err := Process(&mockSSM{
+		paramsOutput: &ssm.GetParametersOutput{
+			Parameters: []*ssm.Parameter{
+				{Name: aws.String("/test/ip"), Value: aws.String("127.0.0.1")},
+			},
+		},
+	}, "", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", cfg.IP.String())
+}
@@ -0,0 +1,188 @@
+package ssmconfig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Provider resolves config values from a secret/config backend. Process groups a spec's
+// tagged fields by provider and calls Fetch once per provider with the full set of names
+// it owns, so an implementation is free to batch, paginate, or cache however makes sense
+// for its backend.
+//
+// Fetch should return whatever names it was able to resolve; a name missing from the
+// returned map is left at its field's zero value, filled by a default, or reported via
+// ErrMissingParameters, depending on that field's tag. err is reserved for backend
+// failures -- the request itself failing -- or, as *ErrUnresolvedParameters, the backend
+// explicitly reporting names it couldn't find; Process already accounts for the latter
+// through each field's default/required handling, so Fetch may return a partial map
+// alongside a non-nil err of either kind.
+type Provider interface {
+	Fetch(ctx context.Context, names []string) (map[string]string, error)
+}
+
+// PathProvider is implemented by Providers that can resolve a whole subtree of
+// parameters under a common path, such as AWS SSM's GetParametersByPath. It's used for
+// fields tagged with ssmpath instead of ssmparam.
+type PathProvider interface {
+	FetchPath(ctx context.Context, path string) (map[string]string, error)
+}
+
+const (
+	// providerSSM is the provider tag value for AWS SSM Parameter Store, and is the
+	// default for any ssmparam field that doesn't set a provider tag.
+	providerSSM = "ssm"
+	// providerSecretsManager is the provider tag value for AWS Secrets Manager.
+	providerSecretsManager = "secretsmanager"
+	// providerEnv is the provider tag value for environment variables.
+	providerEnv = "env"
+)
+
+// Processor resolves a config struct against a registry of named Providers, chosen per
+// field via the `provider` struct tag (defaulting to "ssm").
+type Processor struct {
+	providers map[string]Provider
+}
+
+// NewProcessor builds a Processor backed by the given named providers. Names are matched
+// against a field's `provider` tag, so callers can register custom providers under their
+// own names in addition to, or instead of, the built-in ones.
+func NewProcessor(providers map[string]Provider) *Processor {
+	return &Processor{providers: providers}
+}
+
+// Process fills in any field tagged with ssmparam, resolving each one through the
+// Provider registered under its `provider` tag (or "ssm" if unset).
+//
+// It is equivalent to ProcessWithContext with context.Background().
+func (p *Processor) Process(prefix string, spec interface{}) error {
+	return p.ProcessWithContext(context.Background(), prefix, spec)
+}
+
+// ProcessWithContext is Process, but passes ctx through to every Provider call so fetches
+// can be cancelled or time out.
+func (p *Processor) ProcessWithContext(ctx context.Context, prefix string, spec interface{}) error {
+	fields, paths, err := walkSpec(prefix, spec)
+	if err != nil {
+		return err
+	}
+
+	groups := map[string][]string{}
+	for key, fs := range fields {
+		groups[fs.providerName] = append(groups[fs.providerName], key)
+	}
+
+	var firstErr error
+	var missing []string
+	for providerName, names := range groups {
+		provider, ok := p.providers[providerName]
+		if !ok {
+			return fmt.Errorf("ssmconfig: no provider registered for %q", providerName)
+		}
+
+		values, err := provider.Fetch(ctx, names)
+		for _, name := range names {
+			fs := fields[name]
+			value, resolved := values[name]
+			if !resolved {
+				switch {
+				case fs.hasDefault:
+					value = fs.def
+				case fs.required:
+					missing = append(missing, name)
+					continue
+				default:
+					continue
+				}
+			}
+			if err := setField(value, fs.field); err != nil {
+				return &ErrParseField{Name: name, Kind: fs.field.Kind(), Cause: err}
+			}
+		}
+		// ErrUnresolvedParameters just lists names the loop above has already turned
+		// into a default, a required-but-missing name (surfaced via
+		// ErrMissingParameters below), or a silently-skipped optional field -- so it
+		// never needs to be surfaced itself. Any other error means the provider call
+		// failed outright and should still propagate.
+		if _, unresolved := err.(*ErrUnresolvedParameters); err != nil && !unresolved && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if len(missing) > 0 && firstErr == nil {
+		return &ErrMissingParameters{Names: missing}
+	}
+
+	for _, leaf := range paths {
+		provider, ok := p.providers[leaf.providerName]
+		if !ok {
+			return fmt.Errorf("ssmconfig: no provider registered for %q", leaf.providerName)
+		}
+		pathProvider, ok := provider.(PathProvider)
+		if !ok {
+			return fmt.Errorf("ssmconfig: provider %q does not support ssmpath", leaf.providerName)
+		}
+
+		values, err := pathProvider.FetchPath(ctx, leaf.path)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if err := fillPathLeaf(leaf, values); err != nil {
+			return err
+		}
+	}
+	return firstErr
+}
+
+// fillPathLeaf populates a single ssmpath field from the full-name-keyed values a
+// PathProvider's FetchPath returned for that field's path.
+func fillPathLeaf(leaf pathLeaf, values map[string]string) error {
+	switch leaf.field.Kind() {
+	case reflect.Map:
+		typ := leaf.field.Type()
+		mp := reflect.MakeMap(typ)
+		for name, value := range values {
+			segment := trailingPathSegment(leaf.path, name)
+			elem := reflect.New(typ.Elem()).Elem()
+			if err := setField(value, elem); err != nil {
+				return &ErrParseField{Name: name, Kind: typ.Elem().Kind(), Cause: err}
+			}
+			mp.SetMapIndex(reflect.ValueOf(segment), elem)
+		}
+		leaf.field.Set(mp)
+	case reflect.Struct:
+		typ := leaf.field.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			sub := leaf.field.Field(i)
+			subType := typ.Field(i)
+			if !sub.CanSet() {
+				continue
+			}
+			key := subType.Tag.Get("ssmparam")
+			if key == "" {
+				key = subType.Name
+			}
+			for name, value := range values {
+				if trailingPathSegment(leaf.path, name) != key {
+					continue
+				}
+				if err := setField(value, sub); err != nil {
+					return &ErrParseField{Name: name, Kind: sub.Kind(), Cause: err}
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// trailingPathSegment returns the part of name after path, e.g. "password" for name
+// "/myapp/db/password" and path "/myapp/db".
+func trailingPathSegment(path, name string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(name, strings.TrimSuffix(path, "/")), "/")
+}
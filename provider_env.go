@@ -0,0 +1,27 @@
+package ssmconfig
+
+import (
+	"context"
+	"os"
+)
+
+// EnvProvider resolves config values from environment variables, matching each name
+// exactly (including path-style ssmparam names, e.g. "/myapp/db/password").
+type EnvProvider struct{}
+
+// NewEnvProvider returns a Provider that reads from the process environment.
+func NewEnvProvider() *EnvProvider {
+	return &EnvProvider{}
+}
+
+// Fetch looks up each name via os.LookupEnv; a name with no environment variable set is
+// left out of the returned map.
+func (*EnvProvider) Fetch(ctx context.Context, names []string) (map[string]string, error) {
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		if v, ok := os.LookupEnv(name); ok {
+			values[name] = v
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,41 @@
+package ssmconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+// SecretsManagerProvider resolves config values from AWS Secrets Manager, making one
+// GetSecretValue call per name since, unlike SSM, Secrets Manager has no batch-get API.
+type SecretsManagerProvider struct {
+	svc secretsmanageriface.SecretsManagerAPI
+}
+
+// NewSecretsManagerProvider returns a Provider backed by the given Secrets Manager client.
+func NewSecretsManagerProvider(svc secretsmanageriface.SecretsManagerAPI) *SecretsManagerProvider {
+	return &SecretsManagerProvider{svc: svc}
+}
+
+// Fetch looks up each name as a secret ID. A secret that doesn't exist is left out of the
+// returned map rather than failing the whole call, mirroring how SSM reports
+// InvalidParameters instead of erroring.
+func (p *SecretsManagerProvider) Fetch(ctx context.Context, names []string) (map[string]string, error) {
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		out, err := p.svc.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(name),
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+				continue
+			}
+			return values, err
+		}
+		values[name] = aws.StringValue(out.SecretString)
+	}
+	return values, nil
+}
@@ -0,0 +1,229 @@
+package ssmconfig
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+// ssmGetParametersLimit is the maximum number of names AWS SSM allows in a single
+// GetParameters call.
+const ssmGetParametersLimit = 10
+
+// defaultMaxConcurrentFetches bounds how many GetParameters calls an SSMProvider allows
+// in flight at once when the names being resolved have to be split across multiple
+// requests, unless overridden with WithMaxConcurrentFetches.
+const defaultMaxConcurrentFetches = 5
+
+// RetryConfig controls the backoff SSMProvider uses when a call fails with a throttling
+// or other transient AWS error.
+type RetryConfig struct {
+	// MaxAttempts is the total number of times a call is made, including the first.
+	MaxAttempts int
+	// BaseDelay is how long to wait before the first retry; it doubles after each
+	// subsequent attempt.
+	BaseDelay time.Duration
+}
+
+// defaultRetryConfig is used by NewSSMProvider unless overridden with WithRetryConfig.
+var defaultRetryConfig = RetryConfig{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond}
+
+// SSMProvider resolves config values from AWS SSM Parameter Store.
+type SSMProvider struct {
+	svc            ssmiface.SSMAPI
+	retry          RetryConfig
+	maxConcurrency int
+}
+
+// SSMProviderOption configures an SSMProvider constructed by NewSSMProvider.
+type SSMProviderOption func(*SSMProvider)
+
+// WithRetryConfig overrides the default retry/backoff behavior used for throttled or
+// transient SSM errors.
+func WithRetryConfig(cfg RetryConfig) SSMProviderOption {
+	return func(p *SSMProvider) {
+		p.retry = cfg
+	}
+}
+
+// WithMaxConcurrentFetches overrides how many GetParameters calls an SSMProvider allows
+// in flight at once when the names being resolved have to be split across multiple
+// requests. The default is defaultMaxConcurrentFetches.
+func WithMaxConcurrentFetches(n int) SSMProviderOption {
+	return func(p *SSMProvider) {
+		p.maxConcurrency = n
+	}
+}
+
+// NewSSMProvider returns a Provider backed by the given SSM client.
+func NewSSMProvider(svc ssmiface.SSMAPI, opts ...SSMProviderOption) *SSMProvider {
+	p := &SSMProvider{svc: svc, retry: defaultRetryConfig, maxConcurrency: defaultMaxConcurrentFetches}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Fetch resolves names via GetParametersWithContext.
+func (p *SSMProvider) Fetch(ctx context.Context, names []string) (map[string]string, error) {
+	params, invalid, err := fetchParameters(ctx, p.svc, p.retry, p.maxConcurrency, names)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(params))
+	for _, param := range params {
+		values[aws.StringValue(param.Name)] = aws.StringValue(param.Value)
+	}
+
+	if len(invalid) > 0 {
+		return values, &ErrUnresolvedParameters{Names: invalid}
+	}
+	return values, nil
+}
+
+// FetchPath resolves every parameter under path via GetParametersByPathWithContext,
+// recursing into subpaths and paginating over NextToken until the whole subtree has been
+// read.
+func (p *SSMProvider) FetchPath(ctx context.Context, path string) (map[string]string, error) {
+	values := map[string]string{}
+	var nextToken *string
+	for {
+		var out *ssm.GetParametersByPathOutput
+		err := retryWithBackoff(ctx, p.retry, func() error {
+			var err error
+			out, err = p.svc.GetParametersByPathWithContext(ctx, &ssm.GetParametersByPathInput{
+				Path:           aws.String(path),
+				Recursive:      aws.Bool(true),
+				WithDecryption: aws.Bool(true),
+				NextToken:      nextToken,
+			})
+			return err
+		})
+		if err != nil {
+			return values, err
+		}
+
+		for _, param := range out.Parameters {
+			values[aws.StringValue(param.Name)] = aws.StringValue(param.Value)
+		}
+
+		if aws.StringValue(out.NextToken) == "" {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return values, nil
+}
+
+// fetchParameters resolves names via GetParametersWithContext, splitting them into
+// batches of ssmGetParametersLimit since SSM rejects requests with more than that many
+// names. Batches are fetched concurrently, bounded by maxConcurrency, and their
+// Parameters and InvalidParameters are merged back together.
+func fetchParameters(ctx context.Context, ssmsvc ssmiface.SSMAPI, retry RetryConfig, maxConcurrency int, names []string) ([]*ssm.Parameter, []string, error) {
+	var chunks [][]string
+	for len(names) > 0 {
+		n := ssmGetParametersLimit
+		if n > len(names) {
+			n = len(names)
+		}
+		chunks = append(chunks, names[:n])
+		names = names[n:]
+	}
+
+	var (
+		wg       sync.WaitGroup
+		sem      = make(chan struct{}, maxConcurrency)
+		mu       sync.Mutex
+		params   []*ssm.Parameter
+		invalid  []string
+		firstErr error
+	)
+
+	for _, chunk := range chunks {
+		chunk := chunk
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			chunkNames := make([]*string, len(chunk))
+			for i, n := range chunk {
+				chunkNames[i] = aws.String(n)
+			}
+
+			var out *ssm.GetParametersOutput
+			err := retryWithBackoff(ctx, retry, func() error {
+				var err error
+				out, err = ssmsvc.GetParametersWithContext(ctx, &ssm.GetParametersInput{
+					Names:          chunkNames,
+					WithDecryption: aws.Bool(true),
+				})
+				return err
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			params = append(params, out.Parameters...)
+			for _, name := range out.InvalidParameters {
+				invalid = append(invalid, aws.StringValue(name))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+	return params, invalid, nil
+}
+
+// retryWithBackoff calls fn, retrying with exponential backoff while it fails with a
+// throttling or other retryable AWS error, up to cfg.MaxAttempts attempts. It returns
+// early if ctx is cancelled while waiting between attempts.
+func retryWithBackoff(ctx context.Context, cfg RetryConfig, fn func() error) error {
+	delay := cfg.BaseDelay
+	var err error
+	for attempt := 1; attempt <= cfg.MaxAttempts; attempt++ {
+		err = fn()
+		if err == nil || attempt == cfg.MaxAttempts {
+			return err
+		}
+		if !isRetryableAWSError(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return err
+}
+
+// isRetryableAWSError reports whether err is a throttling or other transient error
+// returned by the AWS SDK. Non-AWS errors (parse failures, context errors, programmer
+// mistakes, ...) are never retried, even though request.IsErrorRetryable treats unknown
+// errors as retryable by default.
+func isRetryableAWSError(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	return request.IsErrorThrottle(aerr) || request.IsErrorRetryable(aerr)
+}
@@ -0,0 +1,105 @@
+package ssmconfig
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSMProviderFetchPathPaginates(t *testing.T) {
+	svc := &mockSSM{
+		paramsByPathPages: []*ssm.GetParametersByPathOutput{
+			{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String("/myapp/flags/a"), Value: aws.String("1")},
+				},
+				NextToken: aws.String("page2"),
+			},
+			{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String("/myapp/flags/b"), Value: aws.String("2")},
+				},
+			},
+		},
+	}
+
+	values, err := NewSSMProvider(svc).FetchPath(nil, "/myapp/flags")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"/myapp/flags/a": "1",
+		"/myapp/flags/b": "2",
+	}, values)
+	assert.Len(t, svc.paramsByPathInputs, 2)
+	assert.Nil(t, svc.paramsByPathInputs[0].NextToken)
+	assert.Equal(t, aws.String("page2"), svc.paramsByPathInputs[1].NextToken)
+}
+
+func TestProcessSSMPathIntoMap(t *testing.T) {
+	type Config struct {
+		Flags map[string]string `ssmpath:"/myapp/flags"`
+	}
+
+	svc := &mockSSM{
+		paramsByPathPages: []*ssm.GetParametersByPathOutput{
+			{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String("/myapp/flags/a"), Value: aws.String("1")},
+					{Name: aws.String("/myapp/flags/b"), Value: aws.String("2")},
+				},
+			},
+		},
+	}
+
+	cfg := &Config{}
+	err := Process(svc, "", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, cfg.Flags)
+}
+
+func TestProcessSSMPathIntoTypedMap(t *testing.T) {
+	type Config struct {
+		Limits map[string]int `ssmpath:"/myapp/limits"`
+	}
+
+	svc := &mockSSM{
+		paramsByPathPages: []*ssm.GetParametersByPathOutput{
+			{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String("/myapp/limits/a"), Value: aws.String("1")},
+					{Name: aws.String("/myapp/limits/b"), Value: aws.String("2")},
+				},
+			},
+		},
+	}
+
+	cfg := &Config{}
+	err := Process(svc, "", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, cfg.Limits)
+}
+
+func TestProcessSSMPathIntoStruct(t *testing.T) {
+	type DBFlags struct {
+		MaxConns int `ssmparam:"max_conns"`
+	}
+	type Config struct {
+		DB DBFlags `ssmpath:"/myapp/db"`
+	}
+
+	svc := &mockSSM{
+		paramsByPathPages: []*ssm.GetParametersByPathOutput{
+			{
+				Parameters: []*ssm.Parameter{
+					{Name: aws.String("/myapp/db/max_conns"), Value: aws.String("10")},
+				},
+			},
+		},
+	}
+
+	cfg := &Config{}
+	err := Process(svc, "", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, Config{DB: DBFlags{MaxConns: 10}}, *cfg)
+}
@@ -0,0 +1,108 @@
+package ssmconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryWithBackoffRetriesThrottledErrors(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	attempts := 0
+
+	err := retryWithBackoff(context.Background(), cfg, func() error {
+		attempts++
+		if attempts < 3 {
+			return awserr.New("ThrottlingException", "slow down", nil)
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryWithBackoffGivesUpOnNonRetryableError(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 5, BaseDelay: time.Millisecond}
+	attempts := 0
+	cause := errors.New("boom")
+
+	err := retryWithBackoff(context.Background(), cfg, func() error {
+		attempts++
+		return cause
+	})
+
+	assert.Equal(t, cause, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryWithBackoffHonorsContextCancellation(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 5, BaseDelay: time.Second}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err := retryWithBackoff(ctx, cfg, func() error {
+		attempts++
+		return awserr.New("ThrottlingException", "slow down", nil)
+	})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, attempts)
+}
+
+// concurrencyTrackingSSM blocks every GetParametersWithContext call on release, recording
+// the highest number it ever saw in flight at once, so tests can assert a concurrency
+// bound is actually being enforced.
+type concurrencyTrackingSSM struct {
+	ssmiface.SSMAPI
+	release     chan struct{}
+	inFlight    int32
+	maxInFlight int32
+}
+
+func (s *concurrencyTrackingSSM) GetParametersWithContext(ctx aws.Context, input *ssm.GetParametersInput, opts ...request.Option) (*ssm.GetParametersOutput, error) {
+	n := atomic.AddInt32(&s.inFlight, 1)
+	for {
+		old := atomic.LoadInt32(&s.maxInFlight)
+		if n <= old || atomic.CompareAndSwapInt32(&s.maxInFlight, old, n) {
+			break
+		}
+	}
+	<-s.release
+	atomic.AddInt32(&s.inFlight, -1)
+	return &ssm.GetParametersOutput{}, nil
+}
+
+func TestWithMaxConcurrentFetchesBoundsInFlightRequests(t *testing.T) {
+	const limit = 2
+	svc := &concurrencyTrackingSSM{release: make(chan struct{})}
+	provider := NewSSMProvider(svc, WithMaxConcurrentFetches(limit))
+
+	names := make([]string, 0, 5*ssmGetParametersLimit)
+	for i := 0; i < cap(names); i++ {
+		names = append(names, fmt.Sprintf("/test/key%d", i))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = provider.Fetch(context.Background(), names)
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	close(svc.release)
+	<-done
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&svc.maxInFlight), int32(limit))
+}
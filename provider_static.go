@@ -0,0 +1,18 @@
+package ssmconfig
+
+import "context"
+
+// StaticProvider is a Provider backed by a fixed map[string]string, useful for tests that
+// need to exercise Process without a real backend.
+type StaticProvider map[string]string
+
+// Fetch returns whichever of names are present in the underlying map.
+func (p StaticProvider) Fetch(ctx context.Context, names []string) (map[string]string, error) {
+	values := make(map[string]string, len(names))
+	for _, name := range names {
+		if v, ok := p[name]; ok {
+			values[name] = v
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,52 @@
+package ssmconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvProviderFetch(t *testing.T) {
+	t.Setenv("SSMCONFIG_TEST_ENV_KEY", "envvalue")
+
+	p := NewEnvProvider()
+	values, err := p.Fetch(nil, []string{"SSMCONFIG_TEST_ENV_KEY", "SSMCONFIG_TEST_MISSING_KEY"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"SSMCONFIG_TEST_ENV_KEY": "envvalue"}, values)
+}
+
+func TestStaticProviderFetch(t *testing.T) {
+	p := StaticProvider{"/test/key": "testvalue"}
+	values, err := p.Fetch(nil, []string{"/test/key", "/test/missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"/test/key": "testvalue"}, values)
+}
+
+func TestProcessorMixesProviders(t *testing.T) {
+	type Config struct {
+		FromStatic string `ssmparam:"/test/key" provider:"static"`
+		FromEnv    string `ssmparam:"ENV_MIXED_KEY" provider:"env"`
+	}
+
+	t.Setenv("ENV_MIXED_KEY", "fromenv")
+
+	cfg := &Config{}
+	proc := NewProcessor(map[string]Provider{
+		"static": StaticProvider{"/test/key": "fromstatic"},
+		"env":    NewEnvProvider(),
+	})
+
+	err := proc.Process("", cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, &Config{FromStatic: "fromstatic", FromEnv: "fromenv"}, cfg)
+}
+
+func TestProcessorErrsOnUnregisteredProvider(t *testing.T) {
+	type Config struct {
+		Key string `ssmparam:"/test/key" provider:"secretsmanager"`
+	}
+
+	proc := NewProcessor(map[string]Provider{})
+	err := proc.Process("", &Config{})
+	assert.EqualError(t, err, `ssmconfig: no provider registered for "secretsmanager"`)
+}
@@ -1,15 +1,13 @@
 package ssmconfig
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 )
 
@@ -22,20 +20,59 @@ type queueObject struct {
 	prefix    string
 }
 
-// Process processes the config struct and any fields with ssmparam tag will be filled.
-// errors if any fields cannot be
+// Process processes the config struct and any fields with ssmparam tag will be filled
+// from AWS SSM Parameter Store, unless the field also sets a `provider` tag naming a
+// different backend (see Processor). errors if a provider call fails, a required field
+// cannot be resolved, or a value cannot be parsed; an optional field with no value and no
+// default is left as it is.
+//
+// It is equivalent to ProcessWithContext with context.Background().
 func Process(ssmsvc ssmiface.SSMAPI, prefix string, spec interface{}) error {
+	return ProcessWithContext(context.Background(), ssmsvc, prefix, spec)
+}
+
+// ProcessWithContext is Process, but honors ctx cancellation while fetching parameters
+// and is passed through to the underlying SSM calls (and their retries).
+func ProcessWithContext(ctx context.Context, ssmsvc ssmiface.SSMAPI, prefix string, spec interface{}) error {
+	return NewProcessor(map[string]Provider{
+		providerSSM: NewSSMProvider(ssmsvc),
+		providerEnv: NewEnvProvider(),
+	}).ProcessWithContext(ctx, prefix, spec)
+}
+
+// pathLeaf is a field tagged with ssmpath, to be filled from an entire parameter subtree
+// rather than a single named value.
+type pathLeaf struct {
+	path         string
+	providerName string
+	field        reflect.Value
+}
+
+// fieldSpec is everything Process needs to resolve a single ssmparam-tagged field: which
+// provider to fetch it from, and what to do if the provider doesn't return a value for it.
+type fieldSpec struct {
+	field        reflect.Value
+	providerName string
+	required     bool
+	def          string
+	hasDefault   bool
+}
+
+// walkSpec traverses spec, collecting every leaf field tagged with ssmparam keyed by its
+// fully prefixed name. Fields tagged with ssmpath instead are collected separately as
+// pathLeaves.
+func walkSpec(prefix string, spec interface{}) (map[string]fieldSpec, []pathLeaf, error) {
 	s := reflect.ValueOf(spec)
 
 	// requires ptr type
 	if s.Kind() != reflect.Ptr {
-		return errors.New("spec must be non-nil pointer")
+		return nil, nil, &ErrInvalidSpec{Reason: "spec must be non-nil pointer"}
 	}
 	s = s.Elem()
 
 	// requires ptr to point to a struct
 	if s.Kind() != reflect.Struct {
-		return errors.New("spec must be a struct type")
+		return nil, nil, &ErrInvalidSpec{Reason: "spec must be a struct type"}
 	}
 
 	typeOfSpec := s.Type()
@@ -52,7 +89,8 @@ func Process(ssmsvc ssmiface.SSMAPI, prefix string, spec interface{}) error {
 		})
 	}
 
-	infos := map[string]reflect.Value{}
+	fields := map[string]fieldSpec{}
+	var paths []pathLeaf
 	for {
 		// quit if queue is empty
 		if len(queue) == 0 {
@@ -68,10 +106,23 @@ func Process(ssmsvc ssmiface.SSMAPI, prefix string, spec interface{}) error {
 			continue
 		}
 
-		ssmparam := q.fieldType.Tag.Get("ssmparam")
+		tag := parseParamTag(q.fieldType.Tag.Get("ssmparam"))
+
+		// a field tagged with ssmpath is filled from a whole parameter subtree, whether
+		// it's a struct (fields matched by name) or a map[string]string (keyed by the
+		// trailing path segment) -- it never recurses or reads ssmparam itself.
+		if ssmpath := q.fieldType.Tag.Get("ssmpath"); ssmpath != "" {
+			paths = append(paths, pathLeaf{
+				path:         q.prefix + ssmpath,
+				providerName: providerNameFor(q.fieldType),
+				field:        q.field,
+			})
+			continue
+		}
 
-		// if field is a sub struct, then we add those fields to the queue
-		if q.field.Kind() == reflect.Struct {
+		// if field is a sub struct, then we add those fields to the queue -- unless it
+		// implements Unmarshaler/TextUnmarshaler itself, in which case it's a leaf value.
+		if q.field.Kind() == reflect.Struct && !implementsUnmarshaler(q.field) {
 			embeddedPtr := q.field.Addr().Interface()
 			s := reflect.ValueOf(embeddedPtr).Elem()
 			typeOfSpec := s.Type()
@@ -84,47 +135,59 @@ func Process(ssmsvc ssmiface.SSMAPI, prefix string, spec interface{}) error {
 					field:     f,
 					fieldType: ftype,
 					// add prefix if the struct has an ssmparam tag so we can build out the ssm in parts
-					prefix: q.prefix + ssmparam,
+					prefix: q.prefix + tag.name,
 				})
 			}
 			continue
 		}
 		// if no ssm param then skip
-		if ssmparam == "" {
+		if tag.name == "" {
 			continue
 		}
-		key := q.prefix + ssmparam
-		infos[key] = q.field
+		key := q.prefix + tag.name
+		fields[key] = fieldSpec{
+			field:        q.field,
+			providerName: providerNameFor(q.fieldType),
+			required:     tag.required,
+			def:          tag.def,
+			hasDefault:   tag.hasDefault,
+		}
 	}
 
-	// makes a list of names from infos keys
-	names := make([]*string, len(infos))
-	i := 0
-	for k := range infos {
-		names[i] = aws.String(k)
-		i++
-	}
+	return fields, paths, nil
+}
 
-	// gets ssm parameters
-	out, err := ssmsvc.GetParameters(&ssm.GetParametersInput{
-		Names:          names,
-		WithDecryption: aws.Bool(true),
-	})
-	if err != nil {
-		return err
+// providerNameFor returns the `provider` tag on ftype, defaulting to providerSSM.
+func providerNameFor(ftype reflect.StructField) string {
+	if providerName := ftype.Tag.Get("provider"); providerName != "" {
+		return providerName
 	}
+	return providerSSM
+}
 
-	// fills in values of the config stuct with values gotten from ssm parameters.
-	for _, param := range out.Parameters {
-		f, ok := infos[aws.StringValue(param.Name)]
-		if !ok { // got something back we didn't ask for
-			continue
-		}
-		if err := processField(aws.StringValue(param.Value), f); err != nil {
-			return err
+// paramTag is the decomposed form of an ssmparam struct tag: the parameter name plus any
+// comma-separated options, e.g. `ssmparam:"/test/key,default=foo,required"`.
+type paramTag struct {
+	name       string
+	required   bool
+	def        string
+	hasDefault bool
+}
+
+// parseParamTag splits an ssmparam tag into its name and options.
+func parseParamTag(tag string) paramTag {
+	parts := strings.Split(tag, ",")
+	pt := paramTag{name: parts[0]}
+	for _, opt := range parts[1:] {
+		switch {
+		case opt == "required":
+			pt.required = true
+		case strings.HasPrefix(opt, "default="):
+			pt.def = strings.TrimPrefix(opt, "default=")
+			pt.hasDefault = true
 		}
 	}
-	return nil
+	return pt
 }
 
 func processField(value string, field reflect.Value) error {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/stretchr/testify/assert"
@@ -13,16 +14,29 @@ import (
 
 type mockSSM struct {
 	ssmiface.SSMAPI
-	paramsOutput *ssm.GetParametersOutput
-	paramsInput  *ssm.GetParametersInput
-	paramsErr    error
+	paramsOutput       *ssm.GetParametersOutput
+	paramsInput        *ssm.GetParametersInput
+	paramsErr          error
+	paramsByPathPages  []*ssm.GetParametersByPathOutput
+	paramsByPathInputs []*ssm.GetParametersByPathInput
+	paramsByPathErr    error
 }
 
-func (s *mockSSM) GetParameters(input *ssm.GetParametersInput) (*ssm.GetParametersOutput, error) {
+func (s *mockSSM) GetParametersWithContext(ctx aws.Context, input *ssm.GetParametersInput, opts ...request.Option) (*ssm.GetParametersOutput, error) {
 	s.paramsInput = input
 	return s.paramsOutput, s.paramsErr
 }
 
+func (s *mockSSM) GetParametersByPathWithContext(ctx aws.Context, input *ssm.GetParametersByPathInput, opts ...request.Option) (*ssm.GetParametersByPathOutput, error) {
+	s.paramsByPathInputs = append(s.paramsByPathInputs, input)
+	if s.paramsByPathErr != nil {
+		return nil, s.paramsByPathErr
+	}
+	page := s.paramsByPathPages[0]
+	s.paramsByPathPages = s.paramsByPathPages[1:]
+	return page, nil
+}
+
 type NestedStringConfig struct {
 	NestedKey string `ssmparam:"/nested/key"`
 }
@@ -201,19 +215,19 @@ func TestProcessCanParse(suite *testing.T) {
 			name:        "errs if bad param config is nil",
 			prefix:      "",
 			config:      nil,
-			expectedErr: errors.New("spec must be non-nil pointer"),
+			expectedErr: &ErrInvalidSpec{Reason: "spec must be non-nil pointer"},
 		},
 		{
 			name:        "errs if bad param config is not ptr",
 			prefix:      "",
 			config:      1,
-			expectedErr: errors.New("spec must be non-nil pointer"),
+			expectedErr: &ErrInvalidSpec{Reason: "spec must be non-nil pointer"},
 		},
 		{
 			name:        "errs if bad param config not struct",
 			prefix:      "",
 			config:      aws.Int(1),
-			expectedErr: errors.New("spec must be a struct type"),
+			expectedErr: &ErrInvalidSpec{Reason: "spec must be a struct type"},
 		},
 		{
 			name: "errs if fails to fetch from ssm",
@@ -233,7 +247,13 @@ func TestProcessCanParse(suite *testing.T) {
 				return
 			}
 			assert.Equal(t, test.expectedConfig, test.config)
-			assert.ElementsMatch(t, test.expectedSSMRequest.Names, test.ssmsvc.paramsInput.Names)
+			// the ssm provider is only called when at least one field routes to it,
+			// so a config with nothing to fetch never populates paramsInput.
+			var gotNames []*string
+			if test.ssmsvc.paramsInput != nil {
+				gotNames = test.ssmsvc.paramsInput.Names
+			}
+			assert.ElementsMatch(t, test.expectedSSMRequest.Names, gotNames)
 		})
 	}
 }
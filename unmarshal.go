@@ -0,0 +1,59 @@
+package ssmconfig
+
+import (
+	"encoding"
+	"reflect"
+)
+
+// Unmarshaler is implemented by types that want to decode their own resolved SSM value
+// instead of going through the built-in processField conversions, e.g. to parse a JSON
+// blob or a custom format.
+type Unmarshaler interface {
+	UnmarshalSSM(value string) error
+}
+
+// implementsUnmarshaler reports whether field's address implements Unmarshaler or
+// encoding.TextUnmarshaler, meaning it should be treated as a leaf value rather than
+// recursed into even though its Kind is Struct.
+func implementsUnmarshaler(field reflect.Value) bool {
+	if !field.CanAddr() {
+		return false
+	}
+	addr := field.Addr()
+	if _, ok := addr.Interface().(Unmarshaler); ok {
+		return true
+	}
+	if _, ok := addr.Interface().(encoding.TextUnmarshaler); ok {
+		return true
+	}
+	return false
+}
+
+// setField fills field with value, preferring a custom Unmarshaler or
+// encoding.TextUnmarshaler implementation (checked in that order) over the built-in
+// conversions in processField.
+func setField(value string, field reflect.Value) error {
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		field.Set(reflect.New(field.Type().Elem()))
+	}
+
+	addr := field
+	if field.Kind() != reflect.Ptr {
+		if !field.CanAddr() {
+			return processField(value, field)
+		}
+		addr = field.Addr()
+	}
+
+	if u, ok := addr.Interface().(Unmarshaler); ok {
+		return u.UnmarshalSSM(value)
+	}
+	if u, ok := addr.Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(value))
+	}
+
+	if field.Kind() == reflect.Ptr {
+		return processField(value, field.Elem())
+	}
+	return processField(value, field)
+}
@@ -0,0 +1,214 @@
+package ssmconfig
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+// defaultWatchInterval is how often a Watcher polls SSM unless overridden with
+// WatchInterval.
+const defaultWatchInterval = 30 * time.Second
+
+// Event is emitted on a Watcher's Changes channel for every ssmparam field whose SSM
+// parameter Version changed since the previous poll.
+type Event struct {
+	// Name is the fully prefixed ssmparam name, e.g. "/myapp/db/password".
+	Name string
+}
+
+// WatcherOption configures a Watcher constructed by New.
+type WatcherOption func(*Watcher)
+
+// WatchInterval sets how often the Watcher polls SSM for changes. The default is 30s.
+func WatchInterval(d time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		w.interval = d
+	}
+}
+
+// Watcher keeps spec up to date by polling SSM on an interval, emitting an Event for
+// every field whose value changed since the previous poll. Only fields resolved from SSM
+// (the default provider, or an explicit `provider:"ssm"` tag) are watched; fields routed
+// to another provider, or tagged ssmpath, are left untouched by Refresh.
+//
+// Refresh may run concurrently with reads of spec done through View, which is the safe
+// way for callers to read spec while a Watcher is running.
+type Watcher struct {
+	ssmsvc   ssmiface.SSMAPI
+	prefix   string
+	spec     interface{}
+	interval time.Duration
+
+	mu       sync.RWMutex
+	versions map[string]int64
+	primed   bool
+
+	changes    chan Event
+	done       chan struct{}
+	publishing sync.RWMutex
+	stopped    sync.Once
+}
+
+// New processes spec once synchronously so it's immediately usable, then starts a
+// background goroutine that reprocesses it every interval (see WatchInterval) until Stop
+// is called. Changes returns the channel that reports which fields changed on each poll.
+func New(ssmsvc ssmiface.SSMAPI, prefix string, spec interface{}, opts ...WatcherOption) (*Watcher, error) {
+	w := &Watcher{
+		ssmsvc:   ssmsvc,
+		prefix:   prefix,
+		spec:     spec,
+		interval: defaultWatchInterval,
+		versions: map[string]int64{},
+		changes:  make(chan Event, 1),
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	if err := w.Refresh(context.Background()); err != nil {
+		return nil, err
+	}
+
+	go w.loop()
+	return w, nil
+}
+
+// Changes returns the channel Events are published on. Sends are non-blocking, so a slow
+// reader misses events rather than stalling Refresh; it's closed once Stop has ended the
+// polling goroutine.
+func (w *Watcher) Changes() <-chan Event {
+	return w.changes
+}
+
+// View calls fn with spec, holding a read lock so fn observes a consistent snapshot that
+// a concurrent Refresh cannot partially overwrite.
+func (w *Watcher) View(fn func(spec interface{})) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	fn(w.spec)
+}
+
+// Stop ends the background polling goroutine and closes Changes. It is safe to call more
+// than once. It waits for any Refresh in progress to finish publishing before closing the
+// channel, so a concurrent or in-flight Refresh can never send on a closed channel.
+func (w *Watcher) Stop() {
+	w.stopped.Do(func() {
+		close(w.done)
+		w.publishing.Lock()
+		defer w.publishing.Unlock()
+		close(w.changes)
+	})
+}
+
+func (w *Watcher) loop() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.Refresh(context.Background())
+		case <-w.done:
+			return
+		}
+	}
+}
+
+// Refresh reprocesses spec against SSM immediately, without waiting for the next poll,
+// and publishes an Event for every watched field whose Parameter.Version changed since
+// the last Refresh.
+func (w *Watcher) Refresh(ctx context.Context) error {
+	fields, _, err := walkSpec(w.prefix, w.spec)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(fields))
+	for name, fs := range fields {
+		if fs.providerName == providerSSM {
+			names = append(names, name)
+		}
+	}
+
+	params, _, err := fetchParameters(ctx, w.ssmsvc, defaultRetryConfig, defaultMaxConcurrentFetches, names)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	resolved := make(map[string]string, len(params))
+	var changed []string
+	for _, param := range params {
+		name := aws.StringValue(param.Name)
+		value := aws.StringValue(param.Value)
+		version := aws.Int64Value(param.Version)
+
+		resolved[name] = value
+		if w.primed {
+			if last, ok := w.versions[name]; !ok || last != version {
+				changed = append(changed, name)
+			}
+		}
+		w.versions[name] = version
+	}
+	w.primed = true
+
+	var missing []string
+	for _, name := range names {
+		fs := fields[name]
+		value, ok := resolved[name]
+		if !ok {
+			switch {
+			case fs.hasDefault:
+				value = fs.def
+			case fs.required:
+				missing = append(missing, name)
+				continue
+			default:
+				continue
+			}
+		}
+		if err := setField(value, fs.field); err != nil {
+			return &ErrParseField{Name: name, Kind: fs.field.Kind(), Cause: err}
+		}
+	}
+	if len(missing) > 0 {
+		return &ErrMissingParameters{Names: missing}
+	}
+
+	w.publish(changed)
+	return nil
+}
+
+// publish sends an Event for each changed name, unless Stop has already closed (or is
+// concurrently closing) the channel. publishing is held for reading here and for writing
+// in Stop, so Stop always waits out any in-flight publish before closing w.changes.
+func (w *Watcher) publish(changed []string) {
+	select {
+	case <-w.done:
+		return
+	default:
+	}
+
+	w.publishing.RLock()
+	defer w.publishing.RUnlock()
+
+	select {
+	case <-w.done:
+		return
+	default:
+	}
+
+	for _, name := range changed {
+		select {
+		case w.changes <- Event{Name: name}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package ssmconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/assert"
+)
+
+// sequentialSSM returns its outputs in order, one per GetParametersWithContext call, so
+// tests can simulate a parameter's Version changing between polls.
+type sequentialSSM struct {
+	mockSSM
+	outputs []*ssm.GetParametersOutput
+}
+
+func (s *sequentialSSM) GetParametersWithContext(ctx aws.Context, input *ssm.GetParametersInput, opts ...request.Option) (*ssm.GetParametersOutput, error) {
+	out := s.outputs[0]
+	s.outputs = s.outputs[1:]
+	return out, nil
+}
+
+type WatcherConfig struct {
+	Key string `ssmparam:"/test/key"`
+}
+
+func TestNewProcessesSpecSynchronously(t *testing.T) {
+	cfg := &WatcherConfig{}
+	svc := &sequentialSSM{outputs: []*ssm.GetParametersOutput{
+		{Parameters: []*ssm.Parameter{
+			{Name: aws.String("/test/key"), Value: aws.String("v1"), Version: aws.Int64(1)},
+		}},
+	}}
+
+	w, err := New(svc, "", cfg, WatchInterval(time.Hour))
+	assert.NoError(t, err)
+	defer w.Stop()
+
+	w.View(func(spec interface{}) {
+		assert.Equal(t, "v1", spec.(*WatcherConfig).Key)
+	})
+}
+
+func TestRefreshEmitsEventOnVersionChange(t *testing.T) {
+	cfg := &WatcherConfig{}
+	svc := &sequentialSSM{outputs: []*ssm.GetParametersOutput{
+		{Parameters: []*ssm.Parameter{
+			{Name: aws.String("/test/key"), Value: aws.String("v1"), Version: aws.Int64(1)},
+		}},
+		{Parameters: []*ssm.Parameter{
+			{Name: aws.String("/test/key"), Value: aws.String("v2"), Version: aws.Int64(2)},
+		}},
+	}}
+
+	w, err := New(svc, "", cfg, WatchInterval(time.Hour))
+	assert.NoError(t, err)
+	defer w.Stop()
+
+	assert.NoError(t, w.Refresh(context.Background()))
+
+	select {
+	case evt := <-w.Changes():
+		assert.Equal(t, Event{Name: "/test/key"}, evt)
+	default:
+		t.Fatal("expected an event on Changes")
+	}
+
+	w.View(func(spec interface{}) {
+		assert.Equal(t, "v2", spec.(*WatcherConfig).Key)
+	})
+}
+
+func TestRefreshSkipsEventWhenVersionUnchanged(t *testing.T) {
+	cfg := &WatcherConfig{}
+	output := &ssm.GetParametersOutput{Parameters: []*ssm.Parameter{
+		{Name: aws.String("/test/key"), Value: aws.String("v1"), Version: aws.Int64(1)},
+	}}
+	svc := &sequentialSSM{outputs: []*ssm.GetParametersOutput{output, output}}
+
+	w, err := New(svc, "", cfg, WatchInterval(time.Hour))
+	assert.NoError(t, err)
+	defer w.Stop()
+
+	assert.NoError(t, w.Refresh(context.Background()))
+
+	select {
+	case evt := <-w.Changes():
+		t.Fatalf("expected no event, got %v", evt)
+	default:
+	}
+}
+
+func TestStopClosesChanges(t *testing.T) {
+	cfg := &WatcherConfig{}
+	svc := &sequentialSSM{outputs: []*ssm.GetParametersOutput{
+		{Parameters: []*ssm.Parameter{
+			{Name: aws.String("/test/key"), Value: aws.String("v1"), Version: aws.Int64(1)},
+		}},
+	}}
+
+	w, err := New(svc, "", cfg, WatchInterval(time.Hour))
+	assert.NoError(t, err)
+
+	w.Stop()
+
+	select {
+	case _, ok := <-w.Changes():
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("Changes was not closed after Stop")
+	}
+}